@@ -18,6 +18,7 @@ import (
 	"prediction-market/internal/database"
 	"prediction-market/internal/handlers"
 	"prediction-market/internal/jobs"
+	"prediction-market/internal/models"
 	"prediction-market/internal/repository"
 	"prediction-market/internal/services"
 )
@@ -104,6 +105,15 @@ func main() {
 	// Initialize admin service
 	adminService := services.NewAdminService(database.GetDB())
 
+	// Initialize feature flag service
+	featureFlagService := services.NewFeatureFlagService(database.GetDB(), cfg.App.Environment)
+
+	// Initialize account deletion service and its grace-period job
+	accountDeletionService := services.NewAccountDeletionService(database.GetDB())
+	accountDeletionProcessor := jobs.NewAccountDeletionProcessor(accountDeletionService, time.Hour)
+	go accountDeletionProcessor.Start()
+	defer accountDeletionProcessor.Stop()
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService)
 	userHandler := handlers.NewUserHandler(userService, adminService)
@@ -116,6 +126,8 @@ func main() {
 	ammHandler := handlers.NewAMMHandler(ammService)
 	positionHandler := handlers.NewPositionHandler(positionService)
 	indexingHandler := handlers.NewIndexingHandler(ammService, duelService)
+	featureFlagHandler := handlers.NewFeatureFlagHandler(featureFlagService, adminService)
+	accountDeletionHandler := handlers.NewAccountDeletionHandler(accountDeletionService, adminService)
 
 	// Set up Gin router
 	router := gin.Default()
@@ -190,6 +202,11 @@ func main() {
 			userRoutes.GET("/invite-codes", userHandler.GetInviteCodes)
 			userRoutes.GET("/referrals", userHandler.GetReferrals)
 			userRoutes.GET("/volume", userHandler.GetUserVolume)
+			userRoutes.GET("/messages", userHandler.GetMessages)
+			userRoutes.POST("/messages/:id/read", userHandler.MarkMessageRead)
+			userRoutes.DELETE("/account", accountDeletionHandler.RequestDeletion)
+			userRoutes.GET("/account/deletion", accountDeletionHandler.GetDeletionStatus)
+			userRoutes.DELETE("/account/deletion", accountDeletionHandler.CancelDeletion)
 		}
 
 		// Trading endpoints (protected) - must come before :id routes
@@ -236,7 +253,7 @@ func main() {
 		api.POST("/escrow/confirm", blockchainHandler.ConfirmEscrowDeposit)
 
 		// Duel endpoints (protected)
-		api.POST("/duels", duelHandler.CreateDuel)
+		api.POST("/duels", featureFlagHandler.RequireFeature(models.FeatureDuels), duelHandler.CreateDuel)
 		api.GET("/duels", duelHandler.GetPlayerDuels)
 		api.GET("/duels/stats", duelHandler.GetPlayerStatistics)
 		api.GET("/duels/config", duelHandler.GetConfig)
@@ -247,7 +264,7 @@ func main() {
 		api.GET("/duels/confirmations/:transactionHash", duelHandler.CheckConfirmations)
 		api.POST("/duels/resolve", duelHandler.ResolveDuelWithPrice)
 		api.POST("/duels/share/x", duelHandler.ShareOnX)
-		api.POST("/duels/:id/join", duelHandler.JoinDuel)
+		api.POST("/duels/:id/join", featureFlagHandler.RequireFeature(models.FeatureDuels), duelHandler.JoinDuel)
 		api.POST("/duels/:id/deposit", duelHandler.DepositToDuel)
 		api.POST("/duels/:id/cancel", duelHandler.CancelDuel)
 		api.GET("/duels/:id/result", duelHandler.GetDuelResult)
@@ -260,7 +277,7 @@ func main() {
 		{
 			amm.POST("/pools", ammHandler.CreatePool)
 			amm.POST("/pools/index", indexingHandler.IndexPoolCreation) // Indexing endpoint
-			amm.POST("/trades", ammHandler.RecordTrade)
+			amm.POST("/trades", featureFlagHandler.RequireFeature(models.FeatureAMMTrading), ammHandler.RecordTrade)
 			amm.GET("/trades/:pool_id", ammHandler.GetTradeHistory)
 			amm.GET("/positions/:pool_id/:user_address", ammHandler.GetUserPosition)
 			amm.GET("/positions/user/:user_address", ammHandler.GetUserPositions)
@@ -277,13 +294,14 @@ func main() {
 
 	// Public duel routes
 	router.GET("/api/duels/:id", duelHandler.GetDuel)
+	router.GET("/api/duels/:id/deposit-info", duelHandler.GetDepositInfo)
 
 	// Public AMM pool routes (GET only - no auth required)
 	router.GET("/api/amm/pools", ammHandler.GetAllPools)
 	router.GET("/api/amm/pools/:id", ammHandler.GetPool)
 	router.GET("/api/amm/pools/market/:market_id", ammHandler.GetPoolByMarket)
 	router.GET("/api/amm/pools/onchain/:pool_id", ammHandler.GetPoolByOnchainID) // NEW: Query by blockchain pool_id
-	router.GET("/api/amm/quote", ammHandler.GetTradeQuote)
+	router.GET("/api/amm/quote", featureFlagHandler.RequireFeature(models.FeatureAMMTrading), ammHandler.GetTradeQuote)
 	router.GET("/api/amm/prices/:pool_id", ammHandler.GetPriceHistory)
 
 	// Public position routes (GET only - no auth required)
@@ -299,6 +317,7 @@ func main() {
 		admin.GET("/dashboard", adminHandler.GetDashboard)
 		admin.GET("/stats", adminHandler.GetPlatformStats)
 		admin.GET("/logs", adminHandler.GetAdminLogs)
+		admin.GET("/search", adminHandler.Search)
 
 		// User management
 		admin.GET("/users", adminHandler.GetUsers)
@@ -307,6 +326,15 @@ func main() {
 		admin.GET("/users/:id/restrictions", adminHandler.GetUserRestrictions)
 		// admin.POST("/users/balance", adminHandler.UpdateUserBalance) // Method not implemented
 		admin.POST("/users/promote", adminHandler.PromoteToAdmin)
+		admin.POST("/users/batch/restrict", adminHandler.BatchRestrictUsers)
+		admin.POST("/users/batch/restrict/csv", adminHandler.ImportRestrictionsCSV)
+		admin.POST("/users/batch/unrestrict", adminHandler.BatchUnrestrictUsers)
+		admin.POST("/users/batch/message", adminHandler.BatchMessageUsers)
+
+		// Account deletion review
+		admin.GET("/account-deletions", accountDeletionHandler.ListRequests)
+		admin.POST("/account-deletions/:id/approve", accountDeletionHandler.ApproveRequest)
+		admin.POST("/account-deletions/:id/reject", accountDeletionHandler.RejectRequest)
 
 		// Market management
 		admin.GET("/markets", adminHandler.GetMarkets)
@@ -322,6 +350,12 @@ func main() {
 		// Duel management
 		admin.POST("/duels/:id/resolve", duelHandler.ResolveDuel)
 		admin.GET("/duels/active", duelHandler.GetActiveDuels)
+
+		// Feature flags
+		admin.GET("/feature-flags", featureFlagHandler.ListFlags)
+		admin.PUT("/feature-flags", featureFlagHandler.UpsertFlag)
+		admin.GET("/feature-flags/:key", featureFlagHandler.GetFlag)
+		admin.DELETE("/feature-flags/:key", featureFlagHandler.DeleteFlag)
 	}
 
 	// Public order book route
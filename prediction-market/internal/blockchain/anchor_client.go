@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -190,6 +191,24 @@ func (c *AnchorClient) GetDuelPDA(duelID uint64) (solana.PublicKey, uint8, error
 	return pda, bump, nil
 }
 
+// GetDuelVaultPDA derives the PDA of the vault that holds a duel's deposits
+func (c *AnchorClient) GetDuelVaultPDA(duelID uint64) (solana.PublicKey, uint8, error) {
+	duelIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(duelIDBytes, duelID)
+
+	seeds := [][]byte{
+		[]byte("duel_vault"),
+		duelIDBytes,
+	}
+
+	pda, bump, err := solana.FindProgramAddress(seeds, c.programID)
+	if err != nil {
+		return solana.PublicKey{}, 0, fmt.Errorf("failed to derive duel vault PDA: %w", err)
+	}
+
+	return pda, bump, nil
+}
+
 // GetPool fetches and deserializes a pool account from the blockchain
 func (c *AnchorClient) GetPool(ctx context.Context, poolID uint64) (*Pool, error) {
 	pda, _, err := c.GetPoolPDA(poolID)
@@ -238,12 +238,7 @@ func (c *AnchorClient) CancelDuel(
 	}
 
 	// Derive vault PDA
-	vaultSeeds := [][]byte{
-		[]byte("duel_vault"),
-		make([]byte, 8),
-	}
-	binary.LittleEndian.PutUint64(vaultSeeds[1], duelID)
-	vaultPDA, _, err := solana.FindProgramAddress(vaultSeeds, c.programID)
+	vaultPDA, _, err := c.GetDuelVaultPDA(duelID)
 	if err != nil {
 		return "", fmt.Errorf("failed to derive vault PDA: %w", err)
 	}
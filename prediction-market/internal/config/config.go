@@ -35,6 +35,7 @@ type AppConfig struct {
 	JWTSecret             string
 	InitialVirtualBalance string
 	InviteCodesPerUser    string
+	Environment           string // Deployment environment name used by feature flags (e.g. development, staging, production)
 }
 
 // SolanaConfig holds Solana network settings
@@ -69,6 +70,7 @@ func Load() (*Config, error) {
 			JWTSecret:             getEnv("JWT_SECRET", ""),
 			InitialVirtualBalance: getEnv("INITIAL_VIRTUAL_BALANCE", "1000.00"),
 			InviteCodesPerUser:    getEnv("INVITE_CODES_PER_USER", "5"),
+			Environment:           getEnv("APP_ENV", "development"),
 		},
 		Solana: SolanaConfig{
 			Network:                getEnv("SOLANA_NETWORK", "devnet"),
@@ -40,6 +40,7 @@ func AutoMigrate() error {
 		&models.MarketEvent{},
 		&models.Transaction{},
 		&models.UserProposal{},
+		&models.AccountDeletionRequest{},
 	}
 
 	for _, model := range coreModels {
@@ -72,6 +73,8 @@ func AutoMigrate() error {
 		&models.PlatformStats{},
 		&models.AdminLog{},
 		&models.UserRestriction{},
+		&models.UserMessage{},
+		&models.FeatureFlag{},
 	}
 
 	for _, model := range adminModels {
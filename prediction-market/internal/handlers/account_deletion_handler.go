@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"prediction-market/internal/auth"
+	"prediction-market/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountDeletionHandler handles account deletion (anonymization) requests
+type AccountDeletionHandler struct {
+	deletionService *services.AccountDeletionService
+	adminService    *services.AdminService
+}
+
+// NewAccountDeletionHandler creates a new account deletion handler
+func NewAccountDeletionHandler(deletionService *services.AccountDeletionService, adminService *services.AdminService) *AccountDeletionHandler {
+	return &AccountDeletionHandler{
+		deletionService: deletionService,
+		adminService:    adminService,
+	}
+}
+
+// RequestDeletion schedules the current user's account for anonymization
+// DELETE /api/user/account
+func (h *AccountDeletionHandler) RequestDeletion(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	_ = c.ShouldBindJSON(&req) // Body is optional
+
+	request, err := h.deletionService.RequestDeletion(userID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    request,
+	})
+}
+
+// GetDeletionStatus returns the current user's pending deletion request
+// GET /api/user/account/deletion
+func (h *AccountDeletionHandler) GetDeletionStatus(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	request, err := h.deletionService.GetOpenRequest(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if request == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No pending deletion request"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    request,
+	})
+}
+
+// CancelDeletion cancels the current user's deletion request during the grace period
+// DELETE /api/user/account/deletion
+func (h *AccountDeletionHandler) CancelDeletion(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	if err := h.deletionService.CancelDeletion(userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Account deletion cancelled",
+	})
+}
+
+// ListRequests returns deletion requests for admin review
+// GET /api/admin/account-deletions
+func (h *AccountDeletionHandler) ListRequests(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	requests, err := h.deletionService.ListRequests(c.Query("status"), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch deletion requests"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    requests,
+		"count":   len(requests),
+	})
+}
+
+// ApproveRequest approves a deletion request for an account holding balances
+// POST /api/admin/account-deletions/:id/approve
+func (h *AccountDeletionHandler) ApproveRequest(c *gin.Context) {
+	h.reviewRequest(c, true)
+}
+
+// RejectRequest rejects a deletion request for an account holding balances
+// POST /api/admin/account-deletions/:id/reject
+func (h *AccountDeletionHandler) RejectRequest(c *gin.Context) {
+	h.reviewRequest(c, false)
+}
+
+func (h *AccountDeletionHandler) reviewRequest(c *gin.Context, approve bool) {
+	adminID := c.GetUint("admin_id")
+	requestID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request ID"})
+		return
+	}
+
+	request, err := h.deletionService.ReviewRequest(uint(requestID), approve, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	action := "REJECT_ACCOUNT_DELETION"
+	if approve {
+		action = "APPROVE_ACCOUNT_DELETION"
+	}
+	h.adminService.LogAdminAction(adminID, action, "USER", &request.UserID, map[string]interface{}{
+		"request_id": request.ID,
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    request,
+	})
+}
@@ -180,6 +180,142 @@ func (h *AdminHandler) GetUserRestrictions(c *gin.Context) {
 	})
 }
 
+// BatchRestrictUsers applies the same restriction to many users
+// POST /api/admin/users/batch/restrict
+func (h *AdminHandler) BatchRestrictUsers(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+
+	var req struct {
+		UserIDs         []uint `json:"user_ids" binding:"required,min=1"`
+		RestrictionType string `json:"restriction_type" binding:"required"`
+		Reason          string `json:"reason"`
+		DurationDays    *int   `json:"duration_days"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	items := make([]services.BatchRestrictItem, len(req.UserIDs))
+	for i, userID := range req.UserIDs {
+		items[i] = services.BatchRestrictItem{
+			UserID:          userID,
+			RestrictionType: req.RestrictionType,
+			Reason:          req.Reason,
+			DurationDays:    req.DurationDays,
+		}
+	}
+
+	results, err := h.adminService.BatchRestrictUsers(items, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	respondBatch(c, results)
+}
+
+// ImportRestrictionsCSV applies restrictions from an uploaded CSV file
+// POST /api/admin/users/batch/restrict/csv
+func (h *AdminHandler) ImportRestrictionsCSV(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "CSV file is required (form field \"file\")"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	items, err := services.ParseRestrictionCSV(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.adminService.BatchRestrictUsers(items, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	respondBatch(c, results)
+}
+
+// BatchUnrestrictUsers lifts active restrictions for many users
+// POST /api/admin/users/batch/unrestrict
+func (h *AdminHandler) BatchUnrestrictUsers(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+
+	var req struct {
+		UserIDs         []uint `json:"user_ids" binding:"required,min=1"`
+		RestrictionType string `json:"restriction_type"` // Optional, empty = all types
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.adminService.BatchUnrestrictUsers(req.UserIDs, req.RestrictionType, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	respondBatch(c, results)
+}
+
+// BatchMessageUsers sends an admin message to many users
+// POST /api/admin/users/batch/message
+func (h *AdminHandler) BatchMessageUsers(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+
+	var req struct {
+		UserIDs []uint `json:"user_ids" binding:"required,min=1"`
+		Subject string `json:"subject" binding:"required,max=255"`
+		Body    string `json:"body" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.adminService.BatchMessageUsers(req.UserIDs, req.Subject, req.Body, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	respondBatch(c, results)
+}
+
+// respondBatch writes per-item batch results with success/failure counts
+func respondBatch(c *gin.Context, results []services.BatchItemResult) {
+	succeeded := 0
+	for _, r := range results {
+		if r.Status == services.BatchItemOK {
+			succeeded++
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"data":      results,
+		"total":     len(results),
+		"succeeded": succeeded,
+		"failed":    len(results) - succeeded,
+	})
+}
+
 // PromoteToAdmin promotes a user to admin
 func (h *AdminHandler) PromoteToAdmin(c *gin.Context) {
 	adminID := c.GetUint("admin_id")
@@ -250,6 +386,32 @@ func (h *AdminHandler) GetPlatformStats(c *gin.Context) {
 	})
 }
 
+// Search looks up a wallet address or transaction signature across the platform
+// GET /api/admin/search?wallet=...|signature=...
+func (h *AdminHandler) Search(c *gin.Context) {
+	wallet := c.Query("wallet")
+	signature := c.Query("signature")
+
+	switch {
+	case wallet != "":
+		result, err := h.adminService.SearchByWallet(wallet)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"success": true, "type": "wallet", "data": result})
+	case signature != "":
+		result, err := h.adminService.SearchBySignature(signature)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"success": true, "type": "signature", "data": result})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "wallet or signature query parameter is required"})
+	}
+}
+
 // GetMarkets returns all markets for admin
 func (h *AdminHandler) GetMarkets(c *gin.Context) {
 	var markets []models.Market
@@ -88,6 +88,24 @@ func (h *DuelHandler) GetDuel(c *gin.Context) {
 	c.JSON(http.StatusOK, duel)
 }
 
+// GetDepositInfo returns the server-derived deposit addresses and amount for a duel
+// GET /api/duels/:id/deposit-info
+func (h *DuelHandler) GetDepositInfo(c *gin.Context) {
+	duelID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid duel id"})
+		return
+	}
+
+	info, err := h.duelService.GetDepositInfo(c.Request.Context(), duelID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, info)
+}
+
 // GetPlayerDuels retrieves all duels for the current player
 // GET /api/duels
 func (h *DuelHandler) GetPlayerDuels(c *gin.Context) {
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	"prediction-market/internal/models"
+	"prediction-market/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeatureFlagHandler handles feature flag checks and admin management
+type FeatureFlagHandler struct {
+	flagService  *services.FeatureFlagService
+	adminService *services.AdminService
+}
+
+// NewFeatureFlagHandler creates a new feature flag handler
+func NewFeatureFlagHandler(flagService *services.FeatureFlagService, adminService *services.AdminService) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flagService:  flagService,
+		adminService: adminService,
+	}
+}
+
+// RequireFeature rejects requests when the given feature is disabled for the caller
+func (h *FeatureFlagHandler) RequireFeature(key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.GetUint("user_id")
+		if !h.flagService.IsEnabled(key, userID) {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "This feature is currently unavailable",
+				"feature": key,
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// ListFlags returns all feature flags
+// GET /api/admin/feature-flags
+func (h *FeatureFlagHandler) ListFlags(c *gin.Context) {
+	flags, err := h.flagService.ListFlags()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch feature flags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flags,
+		"count":   len(flags),
+	})
+}
+
+// GetFlag returns a single feature flag
+// GET /api/admin/feature-flags/:key
+func (h *FeatureFlagHandler) GetFlag(c *gin.Context) {
+	flag, err := h.flagService.GetFlag(c.Param("key"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// UpsertFlag creates or updates a feature flag
+// PUT /api/admin/feature-flags
+func (h *FeatureFlagHandler) UpsertFlag(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+
+	var req models.UpsertFeatureFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	flag, err := h.flagService.UpsertFlag(&req, adminID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.adminService.LogAdminAction(adminID, "UPSERT_FEATURE_FLAG", "FEATURE_FLAG", &flag.ID, map[string]interface{}{
+		"key":             flag.Key,
+		"enabled":         flag.Enabled,
+		"rollout_percent": flag.RolloutPercent,
+		"environments":    flag.Environments,
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// DeleteFlag removes a feature flag
+// DELETE /api/admin/feature-flags/:key
+func (h *FeatureFlagHandler) DeleteFlag(c *gin.Context) {
+	adminID := c.GetUint("admin_id")
+	key := c.Param("key")
+
+	if err := h.flagService.DeleteFlag(key); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.adminService.LogAdminAction(adminID, "DELETE_FEATURE_FLAG", "FEATURE_FLAG", nil, map[string]interface{}{
+		"key": key,
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Feature flag deleted",
+	})
+}
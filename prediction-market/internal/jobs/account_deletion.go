@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"prediction-market/internal/services"
+)
+
+// AccountDeletionProcessor anonymizes accounts whose deletion grace period has elapsed
+type AccountDeletionProcessor struct {
+	deletionService *services.AccountDeletionService
+	interval        time.Duration
+	stopChan        chan struct{}
+}
+
+// NewAccountDeletionProcessor creates a new account deletion job
+func NewAccountDeletionProcessor(deletionService *services.AccountDeletionService, interval time.Duration) *AccountDeletionProcessor {
+	return &AccountDeletionProcessor{
+		deletionService: deletionService,
+		interval:        interval,
+		stopChan:        make(chan struct{}),
+	}
+}
+
+// Start begins the account deletion loop
+func (p *AccountDeletionProcessor) Start() {
+	log.Printf("[AccountDeletionProcessor] Starting account deletion job (interval: %v)", p.interval)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			processed, err := p.deletionService.ProcessDueDeletions()
+			if err != nil {
+				log.Printf("[AccountDeletionProcessor] Error processing deletions: %v", err)
+			} else if processed > 0 {
+				log.Printf("[AccountDeletionProcessor] Anonymized %d account(s)", processed)
+			}
+		case <-p.stopChan:
+			log.Println("[AccountDeletionProcessor] Stopping account deletion job")
+			return
+		}
+	}
+}
+
+// Stop stops the account deletion loop
+func (p *AccountDeletionProcessor) Stop() {
+	close(p.stopChan)
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// AccountDeletionStatus tracks the lifecycle of an account deletion request
+type AccountDeletionStatus string
+
+const (
+	AccountDeletionPending   AccountDeletionStatus = "PENDING"          // Waiting for the grace period to elapse
+	AccountDeletionApproval  AccountDeletionStatus = "PENDING_APPROVAL" // Account holds balances, needs an admin
+	AccountDeletionApproved  AccountDeletionStatus = "APPROVED"
+	AccountDeletionRejected  AccountDeletionStatus = "REJECTED"
+	AccountDeletionCancelled AccountDeletionStatus = "CANCELLED"
+	AccountDeletionCompleted AccountDeletionStatus = "COMPLETED"
+)
+
+// AccountDeletionRequest records a user's request to have their account anonymized
+type AccountDeletionRequest struct {
+	ID               uint                  `gorm:"primaryKey" json:"id"`
+	UserID           uint                  `gorm:"not null;index" json:"user_id"`
+	Status           AccountDeletionStatus `gorm:"size:20;not null;default:PENDING;index" json:"status"`
+	Reason           string                `gorm:"type:text" json:"reason,omitempty"`
+	RequiresApproval bool                  `gorm:"default:false" json:"requires_approval"`
+	ApprovalReason   string                `gorm:"type:text" json:"approval_reason,omitempty"` // Why an admin must sign off (e.g. open positions)
+	ScheduledFor     time.Time             `gorm:"not null;index" json:"scheduled_for"`        // End of the grace period
+	ReviewedBy       *uint                 `json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time            `json:"reviewed_at,omitempty"`
+	CompletedAt      *time.Time            `json:"completed_at,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
+
+// TableName specifies the table name for AccountDeletionRequest model
+func (AccountDeletionRequest) TableName() string {
+	return "account_deletion_requests"
+}
@@ -147,3 +147,18 @@ type UserRestriction struct {
 func (UserRestriction) TableName() string {
 	return "user_restrictions"
 }
+
+// UserMessage is a notice sent by an admin to a user's inbox
+type UserMessage struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	AdminID   uint       `gorm:"not null;index" json:"admin_id"`
+	Subject   string     `gorm:"size:255;not null" json:"subject"`
+	Body      string     `gorm:"type:text;not null" json:"body"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (UserMessage) TableName() string {
+	return "user_messages"
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// Feature flag keys checked by middleware and handlers
+const (
+	FeatureAMMTrading = "amm_trading"
+	FeatureDuels      = "duels"
+	FeatureContests   = "contests"
+)
+
+// FeatureFlag toggles a feature at runtime without a redeploy
+type FeatureFlag struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	Key            string    `gorm:"uniqueIndex;not null;size:100" json:"key"`
+	Description    string    `gorm:"type:text" json:"description"`
+	Enabled        bool      `gorm:"not null;default:false" json:"enabled"`
+	RolloutPercent int       `gorm:"not null;default:100" json:"rollout_percent"` // 0-100, share of users that see the feature
+	Environments   string    `gorm:"size:255" json:"environments"`                // Comma-separated list, empty = all environments
+	UpdatedBy      *uint     `json:"updated_by,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for FeatureFlag model
+func (FeatureFlag) TableName() string {
+	return "feature_flags"
+}
+
+// UpsertFeatureFlagRequest is the admin payload for creating or updating a flag
+type UpsertFeatureFlagRequest struct {
+	Key            string `json:"key" binding:"required"`
+	Description    string `json:"description"`
+	Enabled        bool   `json:"enabled"`
+	RolloutPercent *int   `json:"rollout_percent"`
+	Environments   string `json:"environments"`
+}
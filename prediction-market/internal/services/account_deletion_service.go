@@ -0,0 +1,323 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"prediction-market/internal/models"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// AccountDeletionGracePeriod is how long a user can cancel a deletion request
+const AccountDeletionGracePeriod = 7 * 24 * time.Hour
+
+// activeDuelStatuses are duel states in which a player still has funds at stake
+var activeDuelStatuses = []models.DuelStatus{
+	models.DuelStatusPending,
+	models.DuelStatusMatched,
+	models.DuelStatusWaitingDeposit,
+	models.DuelStatusConfirmingTransaction,
+	models.DuelStatusCountdown,
+	models.DuelStatusStarting,
+	models.DuelStatusActive,
+}
+
+// AccountDeletionService handles account anonymization requests
+type AccountDeletionService struct {
+	db *gorm.DB
+}
+
+// NewAccountDeletionService creates a new account deletion service
+func NewAccountDeletionService(db *gorm.DB) *AccountDeletionService {
+	return &AccountDeletionService{db: db}
+}
+
+// RequestDeletion schedules a user's account for anonymization after the grace period.
+// Accounts with open positions or wallet balances require admin approval.
+func (s *AccountDeletionService) RequestDeletion(userID uint, reason string) (*models.AccountDeletionRequest, error) {
+	if existing, err := s.GetOpenRequest(userID); err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, fmt.Errorf("account deletion already requested")
+	}
+
+	if err := s.checkNoFundsInFlight(userID); err != nil {
+		return nil, err
+	}
+
+	approvalReasons, err := s.balanceApprovalReasons(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &models.AccountDeletionRequest{
+		UserID:       userID,
+		Status:       models.AccountDeletionPending,
+		Reason:       reason,
+		ScheduledFor: time.Now().Add(AccountDeletionGracePeriod),
+	}
+	if len(approvalReasons) > 0 {
+		request.Status = models.AccountDeletionApproval
+		request.RequiresApproval = true
+		request.ApprovalReason = strings.Join(approvalReasons, "; ")
+	}
+
+	if err := s.db.Create(request).Error; err != nil {
+		return nil, fmt.Errorf("failed to create deletion request: %w", err)
+	}
+
+	log.Printf("[AccountDeletion] User %d requested deletion (status=%s, scheduled_for=%s)", userID, request.Status, request.ScheduledFor.Format(time.RFC3339))
+	return request, nil
+}
+
+// GetOpenRequest returns the user's pending deletion request, or nil if there is none
+func (s *AccountDeletionService) GetOpenRequest(userID uint) (*models.AccountDeletionRequest, error) {
+	var request models.AccountDeletionRequest
+	err := s.db.Where("user_id = ? AND status IN ?", userID, []models.AccountDeletionStatus{
+		models.AccountDeletionPending,
+		models.AccountDeletionApproval,
+		models.AccountDeletionApproved,
+	}).Order("created_at DESC").First(&request).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deletion request: %w", err)
+	}
+	return &request, nil
+}
+
+// CancelDeletion cancels a user's pending deletion request during the grace period
+func (s *AccountDeletionService) CancelDeletion(userID uint) error {
+	result := s.db.Model(&models.AccountDeletionRequest{}).
+		Where("user_id = ? AND status IN ?", userID, []models.AccountDeletionStatus{
+			models.AccountDeletionPending,
+			models.AccountDeletionApproval,
+			models.AccountDeletionApproved,
+		}).
+		Update("status", models.AccountDeletionCancelled)
+	if result.Error != nil {
+		return fmt.Errorf("failed to cancel deletion request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("no pending deletion request")
+	}
+	return nil
+}
+
+// ListRequests returns deletion requests, optionally filtered by status
+func (s *AccountDeletionService) ListRequests(status string, limit, offset int) ([]models.AccountDeletionRequest, error) {
+	var requests []models.AccountDeletionRequest
+	query := s.db.Model(&models.AccountDeletionRequest{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list deletion requests: %w", err)
+	}
+	return requests, nil
+}
+
+// ReviewRequest approves or rejects a deletion request that requires admin sign-off
+func (s *AccountDeletionService) ReviewRequest(requestID uint, approve bool, adminID uint) (*models.AccountDeletionRequest, error) {
+	var request models.AccountDeletionRequest
+	if err := s.db.First(&request, requestID).Error; err != nil {
+		return nil, fmt.Errorf("deletion request not found")
+	}
+
+	if request.Status != models.AccountDeletionApproval {
+		return nil, fmt.Errorf("deletion request is not awaiting approval (status: %s)", request.Status)
+	}
+
+	now := time.Now()
+	request.ReviewedBy = &adminID
+	request.ReviewedAt = &now
+	if approve {
+		request.Status = models.AccountDeletionApproved
+	} else {
+		request.Status = models.AccountDeletionRejected
+	}
+
+	if err := s.db.Save(&request).Error; err != nil {
+		return nil, fmt.Errorf("failed to update deletion request: %w", err)
+	}
+	return &request, nil
+}
+
+// ProcessDueDeletions anonymizes every account whose grace period has elapsed
+func (s *AccountDeletionService) ProcessDueDeletions() (int, error) {
+	var requests []models.AccountDeletionRequest
+	if err := s.db.Where("status IN ? AND scheduled_for <= ?", []models.AccountDeletionStatus{
+		models.AccountDeletionPending,
+		models.AccountDeletionApproved,
+	}, time.Now()).Find(&requests).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch due deletion requests: %w", err)
+	}
+
+	processed := 0
+	for _, request := range requests {
+		// Funds may have moved during the grace period - re-check before scrubbing
+		if err := s.checkNoFundsInFlight(request.UserID); err != nil {
+			log.Printf("[AccountDeletion] Deferring deletion for user %d: %v", request.UserID, err)
+			continue
+		}
+
+		if err := s.anonymizeUser(request); err != nil {
+			log.Printf("[AccountDeletion] Failed to anonymize user %d: %v", request.UserID, err)
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// checkNoFundsInFlight rejects deletion while the user has active duels or locked escrow
+func (s *AccountDeletionService) checkNoFundsInFlight(userID uint) error {
+	var activeDuels int64
+	if err := s.db.Model(&models.Duel{}).
+		Where("(player_1_id = ? OR player_2_id = ?) AND status IN ?", userID, userID, activeDuelStatuses).
+		Count(&activeDuels).Error; err != nil {
+		return fmt.Errorf("failed to check active duels: %w", err)
+	}
+	if activeDuels > 0 {
+		return fmt.Errorf("cannot delete account with %d active duel(s)", activeDuels)
+	}
+
+	var lockedHolds int64
+	if err := s.db.Model(&models.DuelEscrowHold{}).
+		Where("user_id = ? AND status = ?", userID, "LOCKED").
+		Count(&lockedHolds).Error; err != nil {
+		return fmt.Errorf("failed to check escrow holds: %w", err)
+	}
+	if lockedHolds > 0 {
+		return fmt.Errorf("cannot delete account with tokens locked in escrow")
+	}
+
+	return nil
+}
+
+// balanceApprovalReasons lists the balances that require an admin to approve deletion
+func (s *AccountDeletionService) balanceApprovalReasons(userID uint) ([]string, error) {
+	var user models.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	var reasons []string
+
+	var wallet models.WalletConnection
+	if err := s.db.Where("user_id = ?", userID).First(&wallet).Error; err == nil {
+		if wallet.TokenBalance.GreaterThan(decimal.Zero) {
+			reasons = append(reasons, fmt.Sprintf("wallet token balance %s %s", wallet.TokenBalance, wallet.TokenSymbol))
+		}
+	}
+
+	var openPositions int64
+	if err := s.db.Model(&models.UserPosition{}).
+		Where("user_address = ? AND status = ?", user.WalletAddress, "OPEN").
+		Count(&openPositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to check positions: %w", err)
+	}
+	if openPositions > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d open position(s)", openPositions))
+	}
+
+	var ammHoldings int64
+	if err := s.db.Model(&models.AMMPosition{}).
+		Where("user_address = ? AND (yes_balance > 0 OR no_balance > 0)", user.WalletAddress).
+		Count(&ammHoldings).Error; err != nil {
+		return nil, fmt.Errorf("failed to check AMM positions: %w", err)
+	}
+	if ammHoldings > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d AMM pool holding(s)", ammHoldings))
+	}
+
+	return reasons, nil
+}
+
+// anonymizeUser scrubs personal fields and pseudonymizes historical records.
+// Duels, trades and positions are kept for accounting but no longer point at the wallet.
+func (s *AccountDeletionService) anonymizeUser(request models.AccountDeletionRequest) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.First(&user, request.UserID).Error; err != nil {
+			return fmt.Errorf("user not found: %w", err)
+		}
+
+		pseudonym := pseudonymFor(user.ID, user.WalletAddress)
+		nickname := fmt.Sprintf("deleted_user_%d", user.ID)
+
+		if err := tx.Model(&models.User{}).Where("id = ?", user.ID).Updates(map[string]interface{}{
+			"wallet_address":  pseudonym,
+			"nickname":        nickname,
+			"x_username":      nil,
+			"x_id":            nil,
+			"x_avatar_url":    nil,
+			"followers_count": 0,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to scrub user: %w", err)
+		}
+
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.WalletConnection{}).Error; err != nil {
+			return fmt.Errorf("failed to remove wallet links: %w", err)
+		}
+
+		// Pseudonymize duel history
+		if err := tx.Model(&models.Duel{}).Where("player_1_id = ?", user.ID).Updates(map[string]interface{}{
+			"player_1_username": nickname,
+			"player_1_avatar":   nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to pseudonymize duels: %w", err)
+		}
+		if err := tx.Model(&models.Duel{}).Where("player_2_id = ?", user.ID).Updates(map[string]interface{}{
+			"player_2_username": nickname,
+			"player_2_avatar":   nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to pseudonymize duels: %w", err)
+		}
+		if err := tx.Model(&models.DuelResult{}).Where("winner_id = ?", user.ID).Updates(map[string]interface{}{
+			"winner_username": nickname,
+			"winner_avatar":   nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to pseudonymize duel results: %w", err)
+		}
+		if err := tx.Model(&models.DuelResult{}).Where("loser_id = ?", user.ID).Updates(map[string]interface{}{
+			"loser_username": nickname,
+			"loser_avatar":   nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to pseudonymize duel results: %w", err)
+		}
+
+		// Pseudonymize address-keyed trading records
+		for _, model := range []interface{}{&models.AMMTrade{}, &models.AMMPosition{}, &models.UserPosition{}} {
+			if err := tx.Model(model).Where("user_address = ?", user.WalletAddress).
+				Update("user_address", pseudonym).Error; err != nil {
+				return fmt.Errorf("failed to pseudonymize %T: %w", model, err)
+			}
+		}
+
+		now := time.Now()
+		if err := tx.Model(&models.AccountDeletionRequest{}).Where("id = ?", request.ID).Updates(map[string]interface{}{
+			"status":       models.AccountDeletionCompleted,
+			"completed_at": now,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to complete deletion request: %w", err)
+		}
+
+		log.Printf("[AccountDeletion] User %d anonymized", user.ID)
+		return nil
+	})
+}
+
+// pseudonymFor derives a stable, non-reversible placeholder for a deleted wallet
+func pseudonymFor(userID uint, walletAddress string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", userID, walletAddress)))
+	return "deleted_" + hex.EncodeToString(sum[:])[:24]
+}
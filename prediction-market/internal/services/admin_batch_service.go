@@ -0,0 +1,258 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"prediction-market/internal/models"
+)
+
+// MaxBatchSize caps the number of users a single batch admin call may touch
+const MaxBatchSize = 500
+
+// Batch item result statuses
+const (
+	BatchItemOK     = "OK"
+	BatchItemFailed = "FAILED"
+)
+
+// ValidRestrictionTypes lists the restriction types admins may apply
+var ValidRestrictionTypes = map[string]bool{
+	"BAN":              true,
+	"SUSPEND":          true,
+	"TRADING_DISABLED": true,
+}
+
+// BatchRestrictItem describes one restriction in a batch or CSV import
+type BatchRestrictItem struct {
+	UserID          uint   `json:"user_id"`
+	WalletAddress   string `json:"wallet_address,omitempty"`
+	RestrictionType string `json:"restriction_type"`
+	Reason          string `json:"reason"`
+	DurationDays    *int   `json:"duration_days,omitempty"`
+}
+
+// BatchItemResult reports the outcome of one item in a batch admin call
+type BatchItemResult struct {
+	UserID        uint   `json:"user_id,omitempty"`
+	WalletAddress string `json:"wallet_address,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+	ResourceID    *uint  `json:"resource_id,omitempty"`
+}
+
+// BatchRestrictUsers applies restrictions item by item; a failure on one user does not stop the rest
+func (s *AdminService) BatchRestrictUsers(items []BatchRestrictItem, adminID uint) ([]BatchItemResult, error) {
+	if len(items) > MaxBatchSize {
+		return nil, fmt.Errorf("batch too large: %d items (max %d)", len(items), MaxBatchSize)
+	}
+
+	results := make([]BatchItemResult, 0, len(items))
+	for _, item := range items {
+		result := BatchItemResult{UserID: item.UserID, WalletAddress: item.WalletAddress}
+
+		userID, err := s.resolveBatchUser(item.UserID, item.WalletAddress)
+		if err != nil {
+			result.Status = BatchItemFailed
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		result.UserID = userID
+
+		if !ValidRestrictionTypes[item.RestrictionType] {
+			result.Status = BatchItemFailed
+			result.Error = fmt.Sprintf("invalid restriction type: %s", item.RestrictionType)
+			results = append(results, result)
+			continue
+		}
+
+		restriction, err := s.RestrictUser(userID, item.RestrictionType, item.Reason, item.DurationDays, adminID)
+		if err != nil {
+			result.Status = BatchItemFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = BatchItemOK
+			result.ResourceID = &restriction.ID
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// BatchUnrestrictUsers deactivates active restrictions for each user.
+// An empty restrictionType lifts every active restriction.
+func (s *AdminService) BatchUnrestrictUsers(userIDs []uint, restrictionType string, adminID uint) ([]BatchItemResult, error) {
+	if len(userIDs) > MaxBatchSize {
+		return nil, fmt.Errorf("batch too large: %d items (max %d)", len(userIDs), MaxBatchSize)
+	}
+
+	results := make([]BatchItemResult, 0, len(userIDs))
+	for _, userID := range userIDs {
+		result := BatchItemResult{UserID: userID}
+
+		query := s.db.Model(&models.UserRestriction{}).Where("user_id = ? AND is_active = ?", userID, true)
+		if restrictionType != "" {
+			query = query.Where("restriction_type = ?", restrictionType)
+		}
+
+		update := query.Update("is_active", false)
+		switch {
+		case update.Error != nil:
+			result.Status = BatchItemFailed
+			result.Error = update.Error.Error()
+		case update.RowsAffected == 0:
+			result.Status = BatchItemFailed
+			result.Error = "no active restrictions"
+		default:
+			result.Status = BatchItemOK
+			uid := userID
+			s.LogAdminAction(adminID, "REMOVE_RESTRICTION", "USER", &uid, map[string]interface{}{
+				"restriction_type": restrictionType,
+				"removed":          update.RowsAffected,
+				"batch":            true,
+			})
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// BatchMessageUsers delivers the same admin message to each user's inbox
+func (s *AdminService) BatchMessageUsers(userIDs []uint, subject, body string, adminID uint) ([]BatchItemResult, error) {
+	if len(userIDs) > MaxBatchSize {
+		return nil, fmt.Errorf("batch too large: %d items (max %d)", len(userIDs), MaxBatchSize)
+	}
+
+	results := make([]BatchItemResult, 0, len(userIDs))
+	for _, userID := range userIDs {
+		result := BatchItemResult{UserID: userID}
+
+		var count int64
+		if err := s.db.Model(&models.User{}).Where("id = ?", userID).Count(&count).Error; err != nil || count == 0 {
+			result.Status = BatchItemFailed
+			result.Error = "user not found"
+			results = append(results, result)
+			continue
+		}
+
+		message := models.UserMessage{
+			UserID:  userID,
+			AdminID: adminID,
+			Subject: subject,
+			Body:    body,
+		}
+		if err := s.db.Create(&message).Error; err != nil {
+			result.Status = BatchItemFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = BatchItemOK
+			result.ResourceID = &message.ID
+		}
+		results = append(results, result)
+	}
+
+	s.LogAdminAction(adminID, "MESSAGE_USERS", "USER", nil, map[string]interface{}{
+		"subject":    subject,
+		"recipients": len(userIDs),
+	})
+
+	return results, nil
+}
+
+// ParseRestrictionCSV reads restriction rows from a CSV export.
+// The header must include user_id or wallet_address and restriction_type;
+// reason and duration_days are optional.
+func ParseRestrictionCSV(r io.Reader) ([]BatchRestrictItem, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	_, hasUserID := columns["user_id"]
+	_, hasWallet := columns["wallet_address"]
+	if !hasUserID && !hasWallet {
+		return nil, fmt.Errorf("CSV must include a user_id or wallet_address column")
+	}
+	if _, ok := columns["restriction_type"]; !ok {
+		return nil, fmt.Errorf("CSV must include a restriction_type column")
+	}
+
+	field := func(row []string, name string) string {
+		if idx, ok := columns[name]; ok && idx < len(row) {
+			return strings.TrimSpace(row[idx])
+		}
+		return ""
+	}
+
+	var items []BatchRestrictItem
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		item := BatchRestrictItem{
+			WalletAddress:   field(row, "wallet_address"),
+			RestrictionType: strings.ToUpper(field(row, "restriction_type")),
+			Reason:          field(row, "reason"),
+		}
+
+		if raw := field(row, "user_id"); raw != "" {
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid user_id %q", line, raw)
+			}
+			item.UserID = uint(id)
+		}
+
+		if raw := field(row, "duration_days"); raw != "" {
+			days, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid duration_days %q", line, raw)
+			}
+			item.DurationDays = &days
+		}
+
+		items = append(items, item)
+		if len(items) > MaxBatchSize {
+			return nil, fmt.Errorf("CSV too large (max %d rows)", MaxBatchSize)
+		}
+	}
+
+	return items, nil
+}
+
+// resolveBatchUser finds a user by ID or, failing that, by wallet address
+func (s *AdminService) resolveBatchUser(userID uint, walletAddress string) (uint, error) {
+	var user models.User
+	switch {
+	case userID != 0:
+		if err := s.db.Select("id").First(&user, userID).Error; err != nil {
+			return 0, fmt.Errorf("user not found")
+		}
+	case walletAddress != "":
+		if err := s.db.Select("id").Where("wallet_address = ?", walletAddress).First(&user).Error; err != nil {
+			return 0, fmt.Errorf("user not found")
+		}
+	default:
+		return 0, fmt.Errorf("user_id or wallet_address is required")
+	}
+	return user.ID, nil
+}
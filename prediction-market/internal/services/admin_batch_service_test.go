@@ -0,0 +1,34 @@
+package services
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRestrictionCSV(t *testing.T) {
+	input := "user_id,wallet_address,restriction_type,reason,duration_days\n" +
+		"12,,ban,sybil cluster,\n" +
+		",9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin,suspend,wash trading,7\n"
+
+	items, err := ParseRestrictionCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseRestrictionCSV failed: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+
+	if items[0].UserID != 12 || items[0].RestrictionType != "BAN" || items[0].DurationDays != nil {
+		t.Errorf("unexpected first item: %+v", items[0])
+	}
+	if items[1].WalletAddress == "" || items[1].RestrictionType != "SUSPEND" || items[1].DurationDays == nil || *items[1].DurationDays != 7 {
+		t.Errorf("unexpected second item: %+v", items[1])
+	}
+
+	if _, err := ParseRestrictionCSV(strings.NewReader("reason\nfoo\n")); err == nil {
+		t.Errorf("expected error for CSV without user column")
+	}
+	if _, err := ParseRestrictionCSV(strings.NewReader("user_id,restriction_type\nabc,BAN\n")); err == nil {
+		t.Errorf("expected error for invalid user_id")
+	}
+}
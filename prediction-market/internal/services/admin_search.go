@@ -0,0 +1,108 @@
+package services
+
+import (
+	"fmt"
+
+	"prediction-market/internal/models"
+)
+
+// adminSearchLimit caps each result list returned by admin search
+const adminSearchLimit = 50
+
+// WalletSearchResult aggregates everything the platform knows about a wallet
+type WalletSearchResult struct {
+	WalletAddress    string                     `json:"wallet_address"`
+	User             *models.User               `json:"user,omitempty"`
+	WalletConnection *models.WalletConnection   `json:"wallet_connection,omitempty"`
+	Restrictions     []models.UserRestriction   `json:"restrictions"`
+	Duels            []models.Duel              `json:"duels"`
+	AMMTrades        []models.AMMTrade          `json:"amm_trades"`
+	AMMPositions     []models.AMMPosition       `json:"amm_positions"`
+	Positions        []models.UserPosition      `json:"positions"`
+	EscrowTxs        []models.EscrowTransaction `json:"escrow_transactions"`
+}
+
+// SignatureSearchResult lists every record that references a transaction signature
+type SignatureSearchResult struct {
+	Signature        string                                 `json:"signature"`
+	Duels            []models.Duel                          `json:"duels"`
+	DuelTransactions []models.DuelTransaction               `json:"duel_transactions"`
+	Confirmations    []models.TransactionConfirmationRecord `json:"confirmations"`
+	AMMTrades        []models.AMMTrade                      `json:"amm_trades"`
+	EscrowTxs        []models.EscrowTransaction             `json:"escrow_transactions"`
+}
+
+// SearchByWallet looks up a wallet address across users, duels, trades and escrow
+func (s *AdminService) SearchByWallet(walletAddress string) (*WalletSearchResult, error) {
+	result := &WalletSearchResult{WalletAddress: walletAddress}
+
+	var user models.User
+	if err := s.db.Where("wallet_address = ?", walletAddress).First(&user).Error; err == nil {
+		result.User = &user
+	}
+
+	var wallet models.WalletConnection
+	if err := s.db.Where("wallet_address = ?", walletAddress).First(&wallet).Error; err == nil {
+		result.WalletConnection = &wallet
+		if result.User == nil {
+			if err := s.db.First(&user, wallet.UserID).Error; err == nil {
+				result.User = &user
+			}
+		}
+	}
+
+	if result.User != nil {
+		userID := result.User.ID
+		if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").
+			Find(&result.Restrictions).Error; err != nil {
+			return nil, fmt.Errorf("failed to search restrictions: %w", err)
+		}
+		if err := s.db.Where("player_1_id = ? OR player_2_id = ?", userID, userID).
+			Order("created_at DESC").Limit(adminSearchLimit).Find(&result.Duels).Error; err != nil {
+			return nil, fmt.Errorf("failed to search duels: %w", err)
+		}
+		if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").
+			Limit(adminSearchLimit).Find(&result.EscrowTxs).Error; err != nil {
+			return nil, fmt.Errorf("failed to search escrow transactions: %w", err)
+		}
+	}
+
+	if err := s.db.Where("user_address = ?", walletAddress).Order("created_at DESC").
+		Limit(adminSearchLimit).Find(&result.AMMTrades).Error; err != nil {
+		return nil, fmt.Errorf("failed to search AMM trades: %w", err)
+	}
+	if err := s.db.Where("user_address = ?", walletAddress).
+		Find(&result.AMMPositions).Error; err != nil {
+		return nil, fmt.Errorf("failed to search AMM positions: %w", err)
+	}
+	if err := s.db.Where("user_address = ?", walletAddress).Order("created_at DESC").
+		Limit(adminSearchLimit).Find(&result.Positions).Error; err != nil {
+		return nil, fmt.Errorf("failed to search positions: %w", err)
+	}
+
+	return result, nil
+}
+
+// SearchBySignature finds every record referencing a Solana transaction signature
+func (s *AdminService) SearchBySignature(signature string) (*SignatureSearchResult, error) {
+	result := &SignatureSearchResult{Signature: signature}
+
+	if err := s.db.Where("transaction_hash = ? OR escrow_tx_hash = ? OR resolution_tx_hash = ?", signature, signature, signature).
+		Find(&result.Duels).Error; err != nil {
+		return nil, fmt.Errorf("failed to search duels: %w", err)
+	}
+	if err := s.db.Where("tx_hash = ?", signature).Find(&result.DuelTransactions).Error; err != nil {
+		return nil, fmt.Errorf("failed to search duel transactions: %w", err)
+	}
+	if err := s.db.Where("transaction_hash = ?", signature).Find(&result.Confirmations).Error; err != nil {
+		return nil, fmt.Errorf("failed to search confirmations: %w", err)
+	}
+	if err := s.db.Where("transaction_signature = ?", signature).Find(&result.AMMTrades).Error; err != nil {
+		return nil, fmt.Errorf("failed to search AMM trades: %w", err)
+	}
+	if err := s.db.Where("transaction_hash = ?", signature).Find(&result.EscrowTxs).Error; err != nil {
+		return nil, fmt.Errorf("failed to search escrow transactions: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"prediction-market/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// DuelDepositMinConfirmations is the number of confirmations a deposit needs before it is accepted
+const DuelDepositMinConfirmations = 1
+
+// DuelDepositMemoPrefix prefixes the memo attached to duel deposits
+const DuelDepositMemoPrefix = "pumpsly-duel:"
+
+// DuelDepositInfo tells a wallet exactly where and how much to deposit for a duel
+type DuelDepositInfo struct {
+	DuelID           uuid.UUID         `json:"duel_id"`
+	OnchainDuelID    int64             `json:"onchain_duel_id"`
+	Status           models.DuelStatus `json:"status"`
+	ProgramID        string            `json:"program_id"`
+	DuelAddress      string            `json:"duel_address"`  // Duel PDA ("duel" + LE duel id)
+	VaultAddress     string            `json:"vault_address"` // Vault PDA ("duel_vault" + LE duel id)
+	ExpectedLamports int64             `json:"expected_lamports"`
+	Currency         int16             `json:"currency"` // 0: SOL, 1: PUMP
+	Memo             string            `json:"memo"`
+	MemoRequired     bool              `json:"memo_required"`
+	MinConfirmations int               `json:"min_confirmations"`
+	AcceptingDeposit bool              `json:"accepting_deposit"`
+}
+
+// DepositMemo returns the memo a deposit for this on-chain duel should carry
+func DepositMemo(onchainDuelID int64) string {
+	return fmt.Sprintf("%s%d", DuelDepositMemoPrefix, onchainDuelID)
+}
+
+// GetDepositInfo derives the deposit addresses for a duel server-side
+func (ds *DuelService) GetDepositInfo(ctx context.Context, duelID uuid.UUID) (*DuelDepositInfo, error) {
+	duel, err := ds.repo.GetDuelByID(ctx, duelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duel: %w", err)
+	}
+
+	duelPDA, _, err := ds.anchorClient.GetDuelPDA(uint64(duel.DuelID))
+	if err != nil {
+		return nil, err
+	}
+
+	vaultPDA, _, err := ds.anchorClient.GetDuelVaultPDA(uint64(duel.DuelID))
+	if err != nil {
+		return nil, err
+	}
+
+	accepting := duel.Status == models.DuelStatusPending ||
+		duel.Status == models.DuelStatusMatched ||
+		duel.Status == models.DuelStatusWaitingDeposit
+
+	return &DuelDepositInfo{
+		DuelID:           duel.ID,
+		OnchainDuelID:    duel.DuelID,
+		Status:           duel.Status,
+		ProgramID:        ds.anchorClient.GetProgramID().String(),
+		DuelAddress:      duelPDA.String(),
+		VaultAddress:     vaultPDA.String(),
+		ExpectedLamports: duel.BetAmount,
+		Currency:         duel.Currency,
+		Memo:             DepositMemo(duel.DuelID),
+		MemoRequired:     false,
+		MinConfirmations: DuelDepositMinConfirmations,
+		AcceptingDeposit: accepting,
+	}, nil
+}
@@ -66,7 +66,7 @@ func (ds *DuelService) CreateDuel(
 	betAmountLamports := int64(req.BetAmount * 1_000_000_000)
 
 	// Verify transaction on blockchain FIRST
-	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, req.Signature, 1)
+	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, req.Signature, DuelDepositMinConfirmations)
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify deposit transaction: %w", err)
 	}
@@ -279,7 +279,7 @@ func (ds *DuelService) JoinDuel(
 
 	// Verify transaction on blockchain
 	log.Printf("[JoinDuel] Verifying transaction %s for duel %s", signature, duelID)
-	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, signature, 1)
+	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, signature, DuelDepositMinConfirmations)
 	if err != nil {
 		log.Printf("[JoinDuel] Transaction verification failed: %v", err)
 		return nil, fmt.Errorf("failed to verify deposit transaction: %w", err)
@@ -439,7 +439,7 @@ func (ds *DuelService) DepositToDuel(
 	}
 
 	// Verify transaction on blockchain (require at least 1 confirmation)
-	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, signature, 1)
+	txDetails, err := ds.solanaClient.VerifyTransaction(ctx, signature, DuelDepositMinConfirmations)
 	if err != nil {
 		return fmt.Errorf("failed to verify transaction: %w", err)
 	}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"prediction-market/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// featureFlagCacheTTL controls how long flags are served from memory before reloading
+const featureFlagCacheTTL = 30 * time.Second
+
+// FeatureFlagService serves feature flags from an in-memory cache backed by the database
+type FeatureFlagService struct {
+	db          *gorm.DB
+	environment string
+
+	mu       sync.RWMutex
+	flags    map[string]models.FeatureFlag
+	loadedAt time.Time
+}
+
+// NewFeatureFlagService creates a new feature flag service for the given environment
+func NewFeatureFlagService(db *gorm.DB, environment string) *FeatureFlagService {
+	return &FeatureFlagService{
+		db:          db,
+		environment: environment,
+		flags:       make(map[string]models.FeatureFlag),
+	}
+}
+
+// IsEnabled reports whether a feature is enabled for a user.
+// Flags that were never created are treated as enabled so that existing
+// features keep working until an admin explicitly turns them off.
+func (s *FeatureFlagService) IsEnabled(key string, userID uint) bool {
+	flag, ok := s.getCached(key)
+	if !ok {
+		return true
+	}
+
+	if !flag.Enabled || !s.appliesToEnvironment(flag) {
+		return false
+	}
+
+	if flag.RolloutPercent >= 100 {
+		return true
+	}
+	if flag.RolloutPercent <= 0 {
+		return false
+	}
+
+	return rolloutBucket(key, userID) < flag.RolloutPercent
+}
+
+// ListFlags returns all feature flags
+func (s *FeatureFlagService) ListFlags() ([]models.FeatureFlag, error) {
+	var flags []models.FeatureFlag
+	if err := s.db.Order("key ASC").Find(&flags).Error; err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	return flags, nil
+}
+
+// GetFlag returns a single feature flag by key
+func (s *FeatureFlagService) GetFlag(key string) (*models.FeatureFlag, error) {
+	var flag models.FeatureFlag
+	if err := s.db.Where("key = ?", key).First(&flag).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("feature flag not found")
+		}
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+	return &flag, nil
+}
+
+// UpsertFlag creates or updates a feature flag and refreshes the cache
+func (s *FeatureFlagService) UpsertFlag(req *models.UpsertFeatureFlagRequest, adminID uint) (*models.FeatureFlag, error) {
+	rollout := 100
+	if req.RolloutPercent != nil {
+		rollout = *req.RolloutPercent
+	}
+	if rollout < 0 || rollout > 100 {
+		return nil, fmt.Errorf("rollout_percent must be between 0 and 100")
+	}
+
+	var flag models.FeatureFlag
+	err := s.db.Where("key = ?", req.Key).First(&flag).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	flag.Key = req.Key
+	flag.Description = req.Description
+	flag.Enabled = req.Enabled
+	flag.RolloutPercent = rollout
+	flag.Environments = req.Environments
+	flag.UpdatedBy = &adminID
+
+	if err := s.db.Save(&flag).Error; err != nil {
+		return nil, fmt.Errorf("failed to save feature flag: %w", err)
+	}
+
+	s.invalidate()
+	log.Printf("[FeatureFlags] %s set to enabled=%v rollout=%d%% by admin %d", flag.Key, flag.Enabled, flag.RolloutPercent, adminID)
+	return &flag, nil
+}
+
+// DeleteFlag removes a feature flag, which re-enables the feature by default
+func (s *FeatureFlagService) DeleteFlag(key string) error {
+	result := s.db.Where("key = ?", key).Delete(&models.FeatureFlag{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("feature flag not found")
+	}
+
+	s.invalidate()
+	return nil
+}
+
+// getCached returns a flag from the cache, reloading it when stale
+func (s *FeatureFlagService) getCached(key string) (models.FeatureFlag, bool) {
+	s.mu.RLock()
+	fresh := time.Since(s.loadedAt) < featureFlagCacheTTL
+	flag, ok := s.flags[key]
+	s.mu.RUnlock()
+
+	if fresh {
+		return flag, ok
+	}
+
+	s.reload()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	flag, ok = s.flags[key]
+	return flag, ok
+}
+
+// reload replaces the cache with the current flags. On failure the stale cache is kept.
+func (s *FeatureFlagService) reload() {
+	var flags []models.FeatureFlag
+	if err := s.db.Find(&flags).Error; err != nil {
+		log.Printf("[FeatureFlags] Failed to reload flags, serving cached values: %v", err)
+		s.mu.Lock()
+		s.loadedAt = time.Now()
+		s.mu.Unlock()
+		return
+	}
+
+	next := make(map[string]models.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		next[flag.Key] = flag
+	}
+
+	s.mu.Lock()
+	s.flags = next
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+}
+
+// invalidate forces the next lookup to reload from the database
+func (s *FeatureFlagService) invalidate() {
+	s.mu.Lock()
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+// appliesToEnvironment checks the flag's environment list against the current environment
+func (s *FeatureFlagService) appliesToEnvironment(flag models.FeatureFlag) bool {
+	if strings.TrimSpace(flag.Environments) == "" {
+		return true
+	}
+	for _, env := range strings.Split(flag.Environments, ",") {
+		if strings.EqualFold(strings.TrimSpace(env), s.environment) {
+			return true
+		}
+	}
+	return false
+}
+
+// rolloutBucket deterministically maps a user to a bucket in [0, 100) for a flag,
+// so the same user keeps seeing the same result as the rollout grows
+func rolloutBucket(key string, userID uint) int {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s:%d", key, userID)
+	return int(h.Sum32() % 100)
+}
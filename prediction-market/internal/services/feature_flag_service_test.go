@@ -0,0 +1,74 @@
+package services
+
+import (
+	"testing"
+
+	"prediction-market/internal/models"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupFeatureFlagDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&models.FeatureFlag{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+func TestFeatureFlagIsEnabled(t *testing.T) {
+	db := setupFeatureFlagDB(t)
+	service := NewFeatureFlagService(db, "production")
+
+	// Unknown flags default to enabled
+	if !service.IsEnabled("unknown", 1) {
+		t.Errorf("expected unknown flag to be enabled")
+	}
+
+	off := 100
+	if _, err := service.UpsertFlag(&models.UpsertFeatureFlagRequest{Key: models.FeatureDuels, Enabled: false, RolloutPercent: &off}, 1); err != nil {
+		t.Fatalf("UpsertFlag failed: %v", err)
+	}
+	if service.IsEnabled(models.FeatureDuels, 1) {
+		t.Errorf("expected disabled flag to be off")
+	}
+
+	// Flag scoped to another environment is off here
+	if _, err := service.UpsertFlag(&models.UpsertFeatureFlagRequest{Key: models.FeatureDuels, Enabled: true, Environments: "staging"}, 1); err != nil {
+		t.Fatalf("UpsertFlag failed: %v", err)
+	}
+	if service.IsEnabled(models.FeatureDuels, 1) {
+		t.Errorf("expected staging-only flag to be off in production")
+	}
+
+	// Partial rollout is deterministic per user and roughly proportional
+	half := 50
+	if _, err := service.UpsertFlag(&models.UpsertFeatureFlagRequest{Key: models.FeatureAMMTrading, Enabled: true, RolloutPercent: &half}, 1); err != nil {
+		t.Fatalf("UpsertFlag failed: %v", err)
+	}
+	enabled := 0
+	for userID := uint(1); userID <= 1000; userID++ {
+		first := service.IsEnabled(models.FeatureAMMTrading, userID)
+		if first != service.IsEnabled(models.FeatureAMMTrading, userID) {
+			t.Fatalf("rollout not deterministic for user %d", userID)
+		}
+		if first {
+			enabled++
+		}
+	}
+	if enabled < 400 || enabled > 600 {
+		t.Errorf("expected ~50%% rollout, got %d/1000", enabled)
+	}
+
+	invalid := 150
+	if _, err := service.UpsertFlag(&models.UpsertFeatureFlagRequest{Key: "bad", RolloutPercent: &invalid}, 1); err == nil {
+		t.Errorf("expected error for rollout_percent > 100")
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -67,6 +68,29 @@ func (s *UserService) UpdateNickname(userID uint, nickname string) error {
 	return nil
 }
 
+// GetUserMessages returns admin messages sent to a user, newest first
+func (s *UserService) GetUserMessages(userID uint, limit int) ([]models.UserMessage, error) {
+	var messages []models.UserMessage
+	if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// MarkMessageRead marks one of the user's messages as read
+func (s *UserService) MarkMessageRead(userID uint, messageID uint) error {
+	result := s.db.Model(&models.UserMessage{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", messageID, userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark message read: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("message not found or already read")
+	}
+	return nil
+}
+
 // UserVolumeStats holds user trading volume statistics
 type UserVolumeStats struct {
 	DuelVolumeSol   float64 `json:"duel_volume_sol"`
@@ -0,0 +1,19 @@
+-- Create account_deletion_requests table for GDPR-style account anonymization
+CREATE TABLE IF NOT EXISTS account_deletion_requests (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    reason TEXT,
+    requires_approval BOOLEAN DEFAULT FALSE,
+    approval_reason TEXT,
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewed_by INTEGER,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletion_requests_user ON account_deletion_requests(user_id);
+CREATE INDEX IF NOT EXISTS idx_account_deletion_requests_status ON account_deletion_requests(status);
+CREATE INDEX IF NOT EXISTS idx_account_deletion_requests_scheduled ON account_deletion_requests(scheduled_for);
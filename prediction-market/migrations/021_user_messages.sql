@@ -0,0 +1,13 @@
+-- Create user_messages table for admin-to-user notices
+CREATE TABLE IF NOT EXISTS user_messages (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    admin_id INTEGER NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_messages_user ON user_messages(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_messages_admin ON user_messages(admin_id);